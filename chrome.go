@@ -4,9 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"math/rand"
+	"net"
 	"os"
 	"os/exec"
 	"strconv"
+	"sync"
 	"time"
 
 	goruntime "runtime"
@@ -14,31 +18,159 @@ import (
 	"github.com/mafredri/cdp"
 	"github.com/mafredri/cdp/devtool"
 	"github.com/mafredri/cdp/protocol/dom"
+	"github.com/mafredri/cdp/protocol/emulation"
 	"github.com/mafredri/cdp/protocol/input"
 	"github.com/mafredri/cdp/protocol/page"
 	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/protocol/security"
+	"github.com/mafredri/cdp/protocol/target"
 	"github.com/mafredri/cdp/rpcc"
 )
 
-// also serves as a sort of mutex
-var _port uint16
-
 // Browser is a struct that contains all the top level variables.
 type Browser struct {
-	w       window
 	options *options
 
-	pid  int
-	conn *rpcc.Conn
-	c    *cdp.Client
-	//sm   *session.Manager
+	pid         int
+	userDataDir string
+	conn        *rpcc.Conn
+	c           *cdp.Client
+	devt        *devtool.DevTools
+
+	// ctx is the long-lived context passed to Start/Connect, as opposed to
+	// the per-call ctx passed to methods like NewPage. Background work that
+	// must outlive the call that started it, like dialog watching, is
+	// scoped to this instead.
+	ctx context.Context
+
+	dialogHandler func(msg, dialogType string) (accept bool, promptText string)
+
+	mu    sync.Mutex
+	pages map[target.ID]*Page
 }
 
+// options is the launch-options surface, mirroring Playwright/xk6-browser
+// LaunchOptions: Chrome CLI flags set before Start spawns the process, plus
+// the emulation overrides applied to every Page once connected.
 type options struct {
+	headless          bool
+	userAgent         string
+	proxy             string
+	viewportWidth     int
+	viewportHeight    int
+	deviceScaleFactor float64
+	locale            string
+	timezone          string
+	ignoreHTTPSErrors bool
+	extraArgs         []string
+	executablePath    string
+	userDataDir       string
+	slowMo            time.Duration
 }
 
 type Option func(option *options) error
 
+// Headless runs Chrome without a visible window.
+func Headless(headless bool) Option {
+	return func(o *options) error {
+		o.headless = headless
+		return nil
+	}
+}
+
+// UserAgent overrides the browser's user agent string.
+func UserAgent(userAgent string) Option {
+	return func(o *options) error {
+		o.userAgent = userAgent
+		return nil
+	}
+}
+
+// Proxy routes all traffic through the given proxy server URL.
+func Proxy(url string) Option {
+	return func(o *options) error {
+		o.proxy = url
+		return nil
+	}
+}
+
+// Viewport sets the browser's viewport size in CSS pixels.
+func Viewport(w, h int) Option {
+	return func(o *options) error {
+		o.viewportWidth = w
+		o.viewportHeight = h
+		return nil
+	}
+}
+
+// DeviceScaleFactor sets the emulated device pixel ratio. Only takes
+// effect alongside Viewport.
+func DeviceScaleFactor(factor float64) Option {
+	return func(o *options) error {
+		o.deviceScaleFactor = factor
+		return nil
+	}
+}
+
+// Locale sets Chrome's UI/Accept-Language locale.
+func Locale(locale string) Option {
+	return func(o *options) error {
+		o.locale = locale
+		return nil
+	}
+}
+
+// Timezone overrides the emulated timezone (IANA id, e.g. "America/Los_Angeles").
+func Timezone(timezone string) Option {
+	return func(o *options) error {
+		o.timezone = timezone
+		return nil
+	}
+}
+
+// IgnoreHTTPSErrors disables certificate validation.
+func IgnoreHTTPSErrors(ignore bool) Option {
+	return func(o *options) error {
+		o.ignoreHTTPSErrors = ignore
+		return nil
+	}
+}
+
+// ExtraArgs appends additional Chrome CLI flags.
+func ExtraArgs(args ...string) Option {
+	return func(o *options) error {
+		o.extraArgs = append(o.extraArgs, args...)
+		return nil
+	}
+}
+
+// ExecutablePath overrides the Chrome binary to launch, taking precedence
+// over the CHROME_BINARY environment variable and platform defaults.
+func ExecutablePath(path string) Option {
+	return func(o *options) error {
+		o.executablePath = path
+		return nil
+	}
+}
+
+// UserDataDir overrides the Chrome profile directory. When unset, Start
+// creates and owns a temporary one, removing it in Close.
+func UserDataDir(dir string) Option {
+	return func(o *options) error {
+		o.userDataDir = dir
+		return nil
+	}
+}
+
+// SlowMo delays every Input.* call by d, useful for slowing automation
+// down to watch it or to look less robotic.
+func SlowMo(d time.Duration) Option {
+	return func(o *options) error {
+		o.slowMo = d
+		return nil
+	}
+}
+
 // New creates a new browser instance with the given context.
 func New(opts ...Option) (*Browser, error) {
 	option := &options{}
@@ -51,16 +183,42 @@ func New(opts ...Option) (*Browser, error) {
 
 	return &Browser{
 		options: option,
+		pages:   make(map[target.ID]*Page),
 	}, nil
 }
 
 func (b *Browser) Close() error {
+	// Close every page opened via NewPage before tearing down the
+	// default connection, so Connect-attached sessions don't leak tabs
+	// in someone else's Chrome.
+	b.mu.Lock()
+	var extra []*Page
+	for _, p := range b.pages {
+		if p.conn == b.conn {
+			continue
+		}
+		extra = append(extra, p)
+	}
+	b.mu.Unlock()
+
+	for _, p := range extra {
+		err := p.Close()
+		if err != nil {
+			return fmt.Errorf("close page: %w", err)
+		}
+	}
+
 	// Close the connection to Chrome.
 	err := b.conn.Close()
 	if err != nil {
 		return fmt.Errorf("close connection: %v", err)
 	}
 
+	// Connect does not own the Chrome process, so there's no pid to kill.
+	if b.pid == 0 {
+		return nil
+	}
+
 	// Kill the Chrome process.
 	cmd := exec.Command("kill", "-9", strconv.Itoa(b.pid))
 	err = cmd.Run()
@@ -68,9 +226,44 @@ func (b *Browser) Close() error {
 		return fmt.Errorf("kill chrome process: %v", err)
 	}
 
+	// Remove the per-instance user data dir created by Start.
+	if b.userDataDir != "" {
+		err = os.RemoveAll(b.userDataDir)
+		if err != nil {
+			return fmt.Errorf("remove user data dir: %v", err)
+		}
+	}
+
 	return nil
 }
 
+// Connect attaches to an already-running Chrome/Chromium instance (or a
+// remote debugger proxy, containerized browser, browserless.io, etc.) via
+// its DevTools HTTP address, instead of spawning a local process via Start.
+// Since Connect does not take ownership of the Chrome process, Close closes
+// the Pages opened via NewPage and the rpcc.Conn but does not kill Chrome.
+func Connect(ctx context.Context, addr string, opts ...Option) (*Browser, error) {
+	option := &options{}
+	for _, opt := range opts {
+		err := opt(option)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	b := &Browser{
+		options: option,
+		pages:   make(map[target.ID]*Page),
+	}
+
+	err := b.connect(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
 func (b *Browser) Start(ctx context.Context) error {
 	// Execute the following command to start Chrome with the default arguments:
 	var startArgs []string = []string{"--disable-notifications", "--kiosk"}
@@ -86,24 +279,58 @@ func (b *Browser) Start(ctx context.Context) error {
 		chromeBinary = os.Getenv("CHROME_BINARY")
 	}
 
-	// Make temp directory
-	tmpDirFlag := "--user-data-dir=" + os.TempDir()
+	// ExecutablePath takes precedence over both of the above.
+	if b.options.executablePath != "" {
+		chromeBinary = b.options.executablePath
+	}
 
-	// Reserve port
-	if _port == 0 {
-		_port = 9000
-	} else {
-		return fmt.Errorf("port is reserved")
+	// Use the caller's profile directory if given; otherwise make a
+	// unique temp one so multiple Browser instances never share a
+	// Chrome profile, and own it so Close cleans it up.
+	userDataDir := b.options.userDataDir
+	if userDataDir == "" {
+		dir, err := os.MkdirTemp("", "goplay-chrome-")
+		if err != nil {
+			return fmt.Errorf("create user data dir: %w", err)
+		}
+		userDataDir = dir
+		b.userDataDir = dir
 	}
 
-	debuggingPortFlag := "--remote-debugging-port=" + strconv.Itoa(int(_port))
+	// Reserve an ephemeral port for this instance instead of a shared one.
+	port, err := reservePort()
+	if err != nil {
+		return fmt.Errorf("reserve port: %w", err)
+	}
 
-	// Add the dynamic flags
-	startArgs = append(startArgs, tmpDirFlag, debuggingPortFlag)
+	startArgs = append(startArgs,
+		"--user-data-dir="+userDataDir,
+		"--remote-debugging-port="+strconv.Itoa(port),
+	)
+
+	if b.options.headless {
+		startArgs = append(startArgs, "--headless=new")
+	}
+	if b.options.userAgent != "" {
+		startArgs = append(startArgs, "--user-agent="+b.options.userAgent)
+	}
+	if b.options.proxy != "" {
+		startArgs = append(startArgs, "--proxy-server="+b.options.proxy)
+	}
+	if b.options.viewportWidth > 0 && b.options.viewportHeight > 0 {
+		startArgs = append(startArgs, fmt.Sprintf("--window-size=%d,%d", b.options.viewportWidth, b.options.viewportHeight))
+	}
+	if b.options.locale != "" {
+		startArgs = append(startArgs, "--lang="+b.options.locale)
+	}
+	if b.options.ignoreHTTPSErrors {
+		startArgs = append(startArgs, "--ignore-certificate-errors")
+	}
+	startArgs = append(startArgs, b.options.extraArgs...)
 
 	// Start Chrome.
 	cmd := exec.Command(chromeBinary, startArgs...)
-	err := cmd.Start()
+	err = cmd.Start()
 	if err != nil {
 		return err
 	}
@@ -114,9 +341,35 @@ func (b *Browser) Start(ctx context.Context) error {
 	// Wait for Chrome to start.
 	time.Sleep(2 * time.Second)
 
-	// Connect to Chrome.
-	devt := devtool.New("http://localhost:" + strconv.Itoa(int(_port)))
-	pageTarget, err := devt.Get(ctx, devtool.Page)
+	return b.connect(ctx, "http://localhost:"+strconv.Itoa(port))
+}
+
+// reservePort binds an ephemeral TCP port, closes it, and returns its
+// number, so each Browser gets its own --remote-debugging-port without
+// coordinating through a shared package-level port.
+func reservePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+// connect dials an already-listening DevTools HTTP address, wraps the
+// initial Page target as the Browser's default Page, and runs the shared
+// post-dial setup (cdp.Client creation, Page/Runtime enable, about:blank,
+// coordinate measurement) used by both Start and Connect.
+func (b *Browser) connect(ctx context.Context, addr string) error {
+	b.ctx = ctx
+
+	if b.pages == nil {
+		b.pages = make(map[target.ID]*Page)
+	}
+
+	b.devt = devtool.New(addr)
+	pageTarget, err := b.devt.Get(ctx, devtool.Page)
 	if err != nil {
 		return err
 	}
@@ -129,38 +382,291 @@ func (b *Browser) Start(ctx context.Context) error {
 	// Create a new cdp.Client.
 	b.c = cdp.NewClient(b.conn)
 
-	// Enable the Page domain.
-	err = b.c.Page.Enable(ctx)
+	// The already-attached devtool.Page target is the Browser's default
+	// Page, driven over the Browser's own connection rather than a
+	// dedicated one of its own.
+	p := &Page{
+		b:        b,
+		targetID: target.ID(pageTarget.ID),
+		conn:     b.conn,
+		c:        b.c,
+	}
+
+	err = p.c.Page.Enable(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = p.c.Runtime.Enable(ctx)
 	if err != nil {
 		return err
 	}
 
-	// Enable the Runtime domain.
-	err = b.c.Runtime.Enable(ctx)
+	err = p.watchDialogs(b.ctx)
 	if err != nil {
 		return err
 	}
 
+	err = b.applyPageOptions(ctx, p)
+	if err != nil {
+		return fmt.Errorf("apply page options: %w", err)
+	}
+
 	// Navigate to about:blank to remove the bookmarks bar from window and screen size.
-	err = b.Navigate(ctx, "about:blank")
+	err = p.Navigate(ctx, "about:blank")
+	if err != nil {
+		return err
+	}
+
+	err = p.setupCoords(ctx)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	b.pages[p.targetID] = p
+	b.mu.Unlock()
+
+	return nil
+}
+
+// applyPageOptions pushes the Browser's launch options that take effect
+// per-page, rather than as Chrome CLI flags, onto p: user agent, viewport
+// and device scale factor, timezone, and certificate-error bypass.
+func (b *Browser) applyPageOptions(ctx context.Context, p *Page) error {
+	o := b.options
+
+	if o.userAgent != "" {
+		err := p.c.Emulation.SetUserAgentOverride(ctx, emulation.NewSetUserAgentOverrideArgs(o.userAgent))
+		if err != nil {
+			return fmt.Errorf("set user agent override: %w", err)
+		}
+	}
+
+	if o.viewportWidth > 0 && o.viewportHeight > 0 {
+		scaleFactor := o.deviceScaleFactor
+		if scaleFactor == 0 {
+			scaleFactor = 1
+		}
+		metricsArgs := emulation.NewSetDeviceMetricsOverrideArgs(o.viewportWidth, o.viewportHeight, scaleFactor, false)
+		err := p.c.Emulation.SetDeviceMetricsOverride(ctx, metricsArgs)
+		if err != nil {
+			return fmt.Errorf("set device metrics override: %w", err)
+		}
+	}
+
+	if o.timezone != "" {
+		err := p.c.Emulation.SetTimezoneOverride(ctx, emulation.NewSetTimezoneOverrideArgs(o.timezone))
+		if err != nil {
+			return fmt.Errorf("set timezone override: %w", err)
+		}
+	}
+
+	if o.ignoreHTTPSErrors {
+		err := p.c.Security.SetIgnoreCertificateErrors(ctx, security.NewSetIgnoreCertificateErrorsArgs(true))
+		if err != nil {
+			return fmt.Errorf("set ignore certificate errors: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NewPage opens a new tab via Target.createTarget and returns a Page that
+// drives it over its own dedicated connection, dialed directly to the
+// target's debugger URL (the same way connect does for the default page).
+// Callers can hold any number of Pages open at once and drive them
+// concurrently, since each owns its own connection rather than sharing
+// the Browser's.
+func (b *Browser) NewPage(ctx context.Context) (*Page, error) {
+	createReply, err := b.c.Target.CreateTarget(ctx, target.NewCreateTargetArgs("about:blank"))
+	if err != nil {
+		return nil, fmt.Errorf("create target: %w", err)
+	}
+
+	targets, err := b.devt.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list targets: %w", err)
+	}
+
+	var wsURL string
+	for _, t := range targets {
+		if target.ID(t.ID) == createReply.TargetID {
+			wsURL = t.WebSocketDebuggerURL
+			break
+		}
+	}
+	if wsURL == "" {
+		return nil, fmt.Errorf("target %s not found", createReply.TargetID)
+	}
+
+	conn, err := rpcc.DialContext(ctx, wsURL)
+	if err != nil {
+		return nil, fmt.Errorf("dial target: %w", err)
+	}
+
+	p := &Page{
+		b:        b,
+		targetID: createReply.TargetID,
+		conn:     conn,
+		c:        cdp.NewClient(conn),
+	}
+
+	err = p.c.Page.Enable(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("enable page domain: %w", err)
+	}
+
+	err = p.c.Runtime.Enable(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("enable runtime domain: %w", err)
+	}
+
+	err = p.watchDialogs(b.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("watch dialogs: %w", err)
+	}
+
+	err = b.applyPageOptions(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf("apply page options: %w", err)
+	}
+
+	err = p.setupCoords(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("setup coords: %w", err)
+	}
+
+	b.mu.Lock()
+	b.pages[p.targetID] = p
+	b.mu.Unlock()
+
+	return p, nil
+}
+
+// Pages returns every Page currently open on the Browser, including its
+// default Page.
+func (b *Browser) Pages() []*Page {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	pages := make([]*Page, 0, len(b.pages))
+	for _, p := range b.pages {
+		pages = append(pages, p)
+	}
+
+	return pages
+}
+
+// OnDialog registers a handler invoked whenever a page opens a
+// JavaScript dialog (alert, confirm, prompt, or beforeunload). The
+// handler's return values decide whether the dialog is accepted, and, for
+// prompts, what text to enter. Without a registered handler, dialogs are
+// dismissed so Click/Navigate don't hang waiting on them.
+func (b *Browser) OnDialog(handler func(msg, dialogType string) (accept bool, promptText string)) {
+	b.dialogHandler = handler
+}
+
+// DialogAlwaysAccept registers a handler that accepts every dialog.
+func (b *Browser) DialogAlwaysAccept() {
+	b.OnDialog(func(msg, dialogType string) (bool, string) {
+		return true, ""
+	})
+}
+
+// DialogAlwaysDismiss registers a handler that dismisses every dialog.
+func (b *Browser) DialogAlwaysDismiss() {
+	b.OnDialog(func(msg, dialogType string) (bool, string) {
+		return false, ""
+	})
+}
+
+// Page owns a single browser tab/target and every method that drives it:
+// Navigate, Evaluate, Select, Click, Text, File and Screenshot. The
+// Browser's default Page (created by Start/Connect) uses the Browser's own
+// connection, and every Page opened via NewPage holds a dedicated one, so
+// driving one never blocks another.
+type Page struct {
+	b *Browser
+
+	targetID target.ID
+	conn     *rpcc.Conn
+	c        *cdp.Client
+
+	w window
+
+	// cx, cy track the current cursor position so MoveMouse can drive it
+	// along a path from wherever it last stopped.
+	cx, cy float64
+}
+
+// Close closes the Page's target in Chrome and its connection, and
+// removes it from its Browser.
+func (p *Page) Close() error {
+	_, err := p.c.Target.CloseTarget(context.Background(), target.NewCloseTargetArgs(p.targetID))
+	if err != nil {
+		return fmt.Errorf("close target: %w", err)
+	}
+
+	err = p.conn.Close()
+	if err != nil {
+		return fmt.Errorf("close connection: %v", err)
+	}
+
+	p.b.mu.Lock()
+	delete(p.b.pages, p.targetID)
+	p.b.mu.Unlock()
+
+	return nil
+}
+
+// watchDialogs subscribes to Page.javascriptDialogOpening and resolves
+// each dialog via the Browser's registered handler, scoped to ctx.
+func (p *Page) watchDialogs(ctx context.Context) error {
+	dialogOpening, err := p.c.Page.JavascriptDialogOpening(ctx)
 	if err != nil {
 		return err
 	}
 
-	b.setupCoords(ctx)
+	go func() {
+		defer dialogOpening.Close()
+
+		for {
+			ev, err := dialogOpening.Recv()
+			if err != nil {
+				return
+			}
+
+			handler := p.b.dialogHandler
+			if handler == nil {
+				handler = func(msg, dialogType string) (bool, string) {
+					return false, ""
+				}
+			}
+
+			accept, promptText := handler(ev.Message, string(ev.Type))
+
+			args := page.NewHandleJavaScriptDialogArgs(accept)
+			if promptText != "" {
+				args.SetPromptText(promptText)
+			}
+
+			p.c.Page.HandleJavaScriptDialog(ctx, args)
+		}
+	}()
 
 	return nil
 }
 
 // Navigate navigates to the given URL.
-func (b *Browser) Navigate(ctx context.Context, url string) error {
+func (p *Page) Navigate(ctx context.Context, url string) error {
 	// Navigate to the page, block until ready.
-	loadEventFired, err := b.c.Page.LoadEventFired(ctx)
+	loadEventFired, err := p.c.Page.LoadEventFired(ctx)
 	if err != nil {
 		return err
 	}
 
-	_, err = b.c.Page.Navigate(ctx, page.NewNavigateArgs(url))
+	_, err = p.c.Page.Navigate(ctx, page.NewNavigateArgs(url))
 	if err != nil {
 		return err
 	}
@@ -175,9 +681,9 @@ func (b *Browser) Navigate(ctx context.Context, url string) error {
 }
 
 // Evaluate evaluates the given JavaScript expression.
-func (b *Browser) Evaluate(ctx context.Context, exp string) (string, error) {
+func (p *Page) Evaluate(ctx context.Context, exp string) (string, error) {
 	// Evaluate the expression.
-	res, err := b.c.Runtime.Evaluate(ctx, runtime.NewEvaluateArgs(exp))
+	res, err := p.c.Runtime.Evaluate(ctx, runtime.NewEvaluateArgs(exp))
 	if err != nil {
 		return "", err
 	}
@@ -213,32 +719,32 @@ type size struct {
 	Height float64 `json:"height"`
 }
 
-func (b *Browser) setupCoords(ctx context.Context) error {
-	w, err := b.getWindowSize(ctx)
+func (p *Page) setupCoords(ctx context.Context) error {
+	w, err := p.getWindowSize(ctx)
 	if err != nil {
 		return fmt.Errorf("get window size: %w", err)
 	}
 
-	s, err := b.getScreenSize(ctx)
+	s, err := p.getScreenSize(ctx)
 	if err != nil {
 		return fmt.Errorf("get screen size: %w", err)
 	}
 
-	b.w.Top = s.Height - w.Height
-	b.w.Bottom = s.Height
-	b.w.Left = 0
-	b.w.Right = s.Width
+	p.w.Top = s.Height - w.Height
+	p.w.Bottom = s.Height
+	p.w.Left = 0
+	p.w.Right = s.Width
 
 	return nil
 }
 
-func (b *Browser) Select(ctx context.Context, selector string) (dom.NodeID, error) {
-	root, err := b.c.DOM.GetDocument(ctx, dom.NewGetDocumentArgs().SetDepth(-1))
+func (p *Page) Select(ctx context.Context, selector string) (dom.NodeID, error) {
+	root, err := p.c.DOM.GetDocument(ctx, dom.NewGetDocumentArgs().SetDepth(-1))
 	if err != nil {
 		return 0, err
 	}
 
-	node, err := b.c.DOM.QuerySelector(ctx, dom.NewQuerySelectorArgs(root.Root.NodeID, selector))
+	node, err := p.c.DOM.QuerySelector(ctx, dom.NewQuerySelectorArgs(root.Root.NodeID, selector))
 	if err != nil {
 		return 0, err
 	}
@@ -247,8 +753,8 @@ func (b *Browser) Select(ctx context.Context, selector string) (dom.NodeID, erro
 }
 
 // getWindowSize returns the window size.
-func (b *Browser) getWindowSize(ctx context.Context) (m size, err error) {
-	s, err := b.Evaluate(ctx, `JSON.stringify({width: window.innerWidth, height: window.innerHeight});`)
+func (p *Page) getWindowSize(ctx context.Context) (m size, err error) {
+	s, err := p.Evaluate(ctx, `JSON.stringify({width: window.innerWidth, height: window.innerHeight});`)
 	if err != nil {
 		return m, err
 	}
@@ -263,8 +769,8 @@ func (b *Browser) getWindowSize(ctx context.Context) (m size, err error) {
 }
 
 // getScreenSize returns the screen size.
-func (b *Browser) getScreenSize(ctx context.Context) (m size, err error) {
-	s, err := b.Evaluate(ctx, `JSON.stringify({width: window.screen.width, height: window.screen.height});`)
+func (p *Page) getScreenSize(ctx context.Context) (m size, err error) {
+	s, err := p.Evaluate(ctx, `JSON.stringify({width: window.screen.width, height: window.screen.height});`)
 	if err != nil {
 		return m, err
 	}
@@ -279,13 +785,13 @@ func (b *Browser) getScreenSize(ctx context.Context) (m size, err error) {
 }
 
 // getCoords returns the x and y coordinates of the given DOMRect.
-func (b *Browser) getCoords(rect domRect) (x, y float64) {
-	return rect.X + (rect.Width / 2), rect.Y + (rect.Height / 2) + b.w.Top
+func (p *Page) getCoords(rect domRect) (x, y float64) {
+	return rect.X + (rect.Width / 2), rect.Y + (rect.Height / 2) + p.w.Top
 }
 
-func (b *Browser) scrollTo(ctx context.Context, id dom.NodeID) error {
+func (p *Page) scrollTo(ctx context.Context, id dom.NodeID) error {
 	scrollArgs := dom.NewScrollIntoViewIfNeededArgs().SetNodeID(id)
-	err := b.c.DOM.ScrollIntoViewIfNeeded(ctx, scrollArgs)
+	err := p.c.DOM.ScrollIntoViewIfNeeded(ctx, scrollArgs)
 	if err != nil {
 		return fmt.Errorf("scroll into view: %w", err)
 	}
@@ -293,36 +799,57 @@ func (b *Browser) scrollTo(ctx context.Context, id dom.NodeID) error {
 	return nil
 }
 
-func (b *Browser) Click(ctx context.Context, id dom.NodeID) error {
-	err := b.scrollTo(ctx, id)
+func (p *Page) Click(ctx context.Context, id dom.NodeID) error {
+	err := p.scrollTo(ctx, id)
 	if err != nil {
 		return fmt.Errorf("scroll to: %w", err)
 	}
 
 	boxArgs := dom.NewGetBoxModelArgs().SetNodeID(id)
-	box, err := b.c.DOM.GetBoxModel(ctx, boxArgs)
+	box, err := p.c.DOM.GetBoxModel(ctx, boxArgs)
 	if err != nil {
 		return fmt.Errorf("get box model: %w", err)
 	}
 
-	x, y := b.getCoords(quadToDOMRect(box.Model.Border))
+	x, y := p.getCoords(quadToDOMRect(box.Model.Border))
+
+	err = p.MoveMouse(ctx, x, y)
+	if err != nil {
+		return fmt.Errorf("move mouse: %w", err)
+	}
+
+	time.Sleep(jitter(40*time.Millisecond, 120*time.Millisecond))
+
 	clickArgs := input.NewDispatchMouseEventArgs("mousePressed", x, y).
 		SetButton("left").
 		SetClickCount(1)
-	err = b.c.Input.DispatchMouseEvent(ctx, clickArgs)
+	err = p.c.Input.DispatchMouseEvent(ctx, clickArgs)
 	if err != nil {
 		return fmt.Errorf("mouse down: %w", err)
 	}
+	p.slowMo()
+
+	time.Sleep(jitter(50*time.Millisecond, 150*time.Millisecond))
 
 	clickArgs.Type = "mouseReleased"
-	err = b.c.Input.DispatchMouseEvent(ctx, clickArgs)
+	err = p.c.Input.DispatchMouseEvent(ctx, clickArgs)
 	if err != nil {
 		return fmt.Errorf("mouse up: %w", err)
 	}
+	p.slowMo()
 
 	return nil
 }
 
+// slowMo sleeps for the Browser's configured SlowMo duration, if any. Every
+// Input.* dispatch calls it afterward so SlowMo evenly paces mouse moves,
+// clicks, and typing rather than only the gaps already hardcoded between them.
+func (p *Page) slowMo() {
+	if p.b.options.slowMo > 0 {
+		time.Sleep(p.b.options.slowMo)
+	}
+}
+
 func quadToDOMRect(q dom.Quad) domRect {
 	return domRect{
 		X:      q[0],
@@ -332,9 +859,100 @@ func quadToDOMRect(q dom.Quad) domRect {
 	}
 }
 
-func (b *Browser) Screenshot(ctx context.Context) ([]byte, error) {
-	screenshotArgs := page.NewCaptureScreenshotArgs().SetFormat("png")
-	screenshot, err := b.c.Page.CaptureScreenshot(ctx, screenshotArgs)
+// MoveMouse drives the cursor from its current position to (x, y) along a
+// cubic Bezier curve instead of jumping straight there, dispatching a
+// mouseMoved event at each sampled step. This defeats bot-detection
+// heuristics that flag teleporting cursors.
+func (p *Page) MoveMouse(ctx context.Context, x, y float64) error {
+	startX, startY := p.cx, p.cy
+
+	dx, dy := x-startX, y-startY
+	distance := math.Hypot(dx, dy)
+
+	// Unit vector perpendicular to the straight line, used to bow the
+	// control points off of it.
+	var perpX, perpY float64
+	if distance > 0 {
+		perpX, perpY = -dy/distance, dx/distance
+	}
+
+	offset := distance * 0.3
+	c1x := startX + dx*0.33 + perpX*(rand.Float64()*2-1)*offset
+	c1y := startY + dy*0.33 + perpY*(rand.Float64()*2-1)*offset
+	c2x := startX + dx*0.66 + perpX*(rand.Float64()*2-1)*offset
+	c2y := startY + dy*0.66 + perpY*(rand.Float64()*2-1)*offset
+
+	steps := int(math.Max(25, distance/8))
+	for i := 1; i <= steps; i++ {
+		t := float64(i) / float64(steps)
+		eased := t * t * (3 - 2*t)
+
+		px, py := cubicBezier(startX, startY, c1x, c1y, c2x, c2y, x, y, eased)
+
+		moveArgs := input.NewDispatchMouseEventArgs("mouseMoved", px, py)
+		err := p.c.Input.DispatchMouseEvent(ctx, moveArgs)
+		if err != nil {
+			return fmt.Errorf("mouse moved: %w", err)
+		}
+		p.slowMo()
+
+		time.Sleep(jitter(4*time.Millisecond, 16*time.Millisecond))
+	}
+
+	p.cx, p.cy = x, y
+
+	return nil
+}
+
+// cubicBezier evaluates a cubic Bezier curve with control points
+// (x0,y0), (x1,y1), (x2,y2), (x3,y3) at t in [0, 1].
+func cubicBezier(x0, y0, x1, y1, x2, y2, x3, y3, t float64) (x, y float64) {
+	u := 1 - t
+	x = u*u*u*x0 + 3*u*u*t*x1 + 3*u*t*t*x2 + t*t*t*x3
+	y = u*u*u*y0 + 3*u*u*t*y1 + 3*u*t*t*y2 + t*t*t*y3
+	return x, y
+}
+
+// jitter returns a random duration in [min, max).
+func jitter(min, max time.Duration) time.Duration {
+	return min + time.Duration(rand.Int63n(int64(max-min)))
+}
+
+// ScreenshotOptions configures Page.Screenshot.
+type ScreenshotOptions struct {
+	Format                string // "png" or "jpeg"; defaults to "png".
+	Quality               int    // JPEG quality (0-100); ignored for png.
+	Clip                  *domRect
+	FromSurface           bool
+	CaptureBeyondViewport bool
+}
+
+func (p *Page) Screenshot(ctx context.Context, opts ScreenshotOptions) ([]byte, error) {
+	format := opts.Format
+	if format == "" {
+		format = "png"
+	}
+
+	screenshotArgs := page.NewCaptureScreenshotArgs().
+		SetFormat(format).
+		SetFromSurface(opts.FromSurface).
+		SetCaptureBeyondViewport(opts.CaptureBeyondViewport)
+
+	if format == "jpeg" && opts.Quality > 0 {
+		screenshotArgs.SetQuality(opts.Quality)
+	}
+
+	if opts.Clip != nil {
+		screenshotArgs.SetClip(page.Viewport{
+			X:      opts.Clip.X,
+			Y:      opts.Clip.Y,
+			Width:  opts.Clip.Width,
+			Height: opts.Clip.Height,
+			Scale:  1,
+		})
+	}
+
+	screenshot, err := p.c.Page.CaptureScreenshot(ctx, screenshotArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -342,24 +960,54 @@ func (b *Browser) Screenshot(ctx context.Context) ([]byte, error) {
 	return screenshot.Data, nil
 }
 
-func (b *Browser) Text(ctx context.Context, s string) error {
+// ScreenshotFullPage reads the page's full content size via
+// Page.getLayoutMetrics, resizes the emulated viewport to match, captures
+// the screenshot, then clears the device metrics override. Clearing it also
+// drops any override the Viewport option applied to the Page.
+func (p *Page) ScreenshotFullPage(ctx context.Context) ([]byte, error) {
+	metrics, err := p.c.Page.GetLayoutMetrics(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get layout metrics: %w", err)
+	}
+
+	content := metrics.CSSContentSize
+
+	overrideArgs := emulation.NewSetDeviceMetricsOverrideArgs(int(content.Width), int(content.Height), 1, false)
+	err = p.c.Emulation.SetDeviceMetricsOverride(ctx, overrideArgs)
+	if err != nil {
+		return nil, fmt.Errorf("set device metrics override: %w", err)
+	}
+	defer p.c.Emulation.ClearDeviceMetricsOverride(ctx)
+
+	return p.Screenshot(ctx, ScreenshotOptions{
+		Clip: &domRect{
+			X:      content.X,
+			Y:      content.Y,
+			Width:  content.Width,
+			Height: content.Height,
+		},
+	})
+}
+
+func (p *Page) Text(ctx context.Context, s string) error {
 	args := input.NewInsertTextArgs(s)
-	err := b.c.Input.InsertText(ctx, args)
+	err := p.c.Input.InsertText(ctx, args)
 	if err != nil {
 		return fmt.Errorf("insert text: %w", err)
 	}
+	p.slowMo()
 
 	return nil
 }
 
-func (b *Browser) File(ctx context.Context, id dom.NodeID, paths []string) error {
-	err := b.scrollTo(ctx, id)
+func (p *Page) File(ctx context.Context, id dom.NodeID, paths []string) error {
+	err := p.scrollTo(ctx, id)
 	if err != nil {
 		return fmt.Errorf("scroll to: %w", err)
 	}
 
 	args := dom.NewSetFileInputFilesArgs(paths).SetNodeID(id)
-	err = b.c.DOM.SetFileInputFiles(ctx, args)
+	err = p.c.DOM.SetFileInputFiles(ctx, args)
 	if err != nil {
 		return fmt.Errorf("set file input files: %w", err)
 	}
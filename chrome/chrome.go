@@ -1,30 +1,58 @@
 package chrome
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"regexp"
+	"strconv"
+	"sync"
 	"time"
 
 	goruntime "runtime"
 
 	"github.com/mafredri/cdp"
 	"github.com/mafredri/cdp/devtool"
+	"github.com/mafredri/cdp/protocol/emulation"
 	"github.com/mafredri/cdp/protocol/page"
 	"github.com/mafredri/cdp/protocol/runtime"
+	"github.com/mafredri/cdp/protocol/target"
 	"github.com/mafredri/cdp/rpcc"
 )
 
-// Browser is a struct that contains all the top level variables.
+// Browser is a struct that contains all the top level variables. Navigate,
+// Evaluate, GetBoundingClientRect, and the screenshot methods are exposed
+// here for backward compatibility and delegate to the current tab; use
+// NewTab/Tabs for direct multi-tab control.
 type Browser struct {
-	Top     float64
-	Bottom  float64
-	Left    float64
-	Right   float64
-	Client  *cdp.Client
 	Context context.Context
+
+	pid  int // 0 when Connect attached to a Chrome this Browser doesn't own.
+	devt *devtool.DevTools
+
+	dialogHandler func(ev DialogEvent) DialogResponse
+
+	mu      sync.Mutex
+	current *Tab
+	tabs    map[target.ID]*Tab
+}
+
+// DialogEvent describes a JavaScript dialog (alert, confirm, prompt, or
+// beforeunload) the page has opened.
+type DialogEvent struct {
+	Type    string // "alert", "confirm", "prompt", or "beforeunload".
+	Message string
+}
+
+// DialogResponse is returned by a dialog handler to decide how to resolve
+// the dialog DialogEvent describes.
+type DialogResponse struct {
+	Accept     bool
+	PromptText string // Only used when Type is "prompt".
 }
 
 // DOMRect is a struct representing a DOMRect.
@@ -47,17 +75,35 @@ type ScreenSize struct {
 	Height float64 `json:"height"`
 }
 
-// New creates a new browser instance with the given context.
-func New(ctx context.Context) (*Browser, error) {
-	b := &Browser{
-		Top:     0,
-		Bottom:  0,
-		Left:    0,
-		Right:   0,
-		Client:  &cdp.Client{},
-		Context: ctx,
+// Config controls how New launches Chrome.
+type Config struct {
+	Headless      bool
+	DebuggingPort int // 0 picks a free port.
+	UserDataDir   string
+	WindowSize    WindowSize
+	ExtraArgs     []string
+	Env           []string // Extra environment variables, appended to the current process's.
+}
+
+// New creates a new browser instance with the given context and config.
+func New(ctx context.Context, cfg Config) (*Browser, error) {
+	b := &Browser{Context: ctx}
+	err := b.startChrome(cfg)
+	if err != nil {
+		return nil, err
 	}
-	err := b.startChrome()
+
+	return b, nil
+}
+
+// Connect attaches to an already-running Chrome's DevTools HTTP address
+// (e.g. "http://localhost:9222") instead of launching a local binary. This
+// lets goplay drive a Chrome running in another container, on a remote
+// host, or one already managed by something else. Since Connect doesn't
+// start the process, Close won't kill it.
+func Connect(ctx context.Context, addr string) (*Browser, error) {
+	b := &Browser{Context: ctx}
+	err := b.connect(addr)
 	if err != nil {
 		return nil, err
 	}
@@ -65,11 +111,75 @@ func New(ctx context.Context) (*Browser, error) {
 	return b, nil
 }
 
-// startChrome starts a new Chrome instance and returns a cdp.Client.
-func (b *Browser) startChrome() error {
+// currentTab returns the Browser's current tab, or an error if it was
+// closed out from under the Browser via Tab.Close.
+func (b *Browser) currentTab() (*Tab, error) {
+	b.mu.Lock()
+	t := b.current
+	b.mu.Unlock()
+
+	if t == nil {
+		return nil, fmt.Errorf("no current tab: it was closed via Tab.Close")
+	}
+
+	return t, nil
+}
+
+// Close tears down the Browser's connection to Chrome, and, if this
+// Browser started the process (i.e. it was created via New rather than
+// Connect), kills it. Close returns once the kill command has run; it does
+// not wait for Chrome to exit.
+func (b *Browser) Close() error {
+	b.mu.Lock()
+	t := b.current
+	b.mu.Unlock()
+
+	// A current tab closed via Tab.Close has already closed its connection.
+	if t != nil {
+		err := t.conn.Close()
+		if err != nil {
+			return fmt.Errorf("close connection: %w", err)
+		}
+	}
+
+	if b.pid == 0 {
+		return nil
+	}
+
+	cmd := exec.Command("kill", strconv.Itoa(b.pid))
+	err := cmd.Run()
+	if err != nil {
+		return fmt.Errorf("kill chrome process: %w", err)
+	}
+
+	return nil
+}
+
+// startChrome starts a new Chrome instance per cfg and connects to it.
+func (b *Browser) startChrome(cfg Config) error {
 	// Execute the following command to start Chrome with the default arguments:
 	// google-chrome --remote-debugging-port=9222 --disable-notifications --kiosk
-	var startArgs []string = []string{"--remote-debugging-port=9222", "--disable-notifications", "--kiosk"}
+	var startArgs []string = []string{"--disable-notifications", "--kiosk"}
+
+	if cfg.Headless {
+		startArgs = append(startArgs, "--headless=new", "--disable-gpu", "--hide-scrollbars")
+	}
+	if cfg.UserDataDir != "" {
+		startArgs = append(startArgs, "--user-data-dir="+cfg.UserDataDir)
+	}
+	if cfg.WindowSize.Width > 0 && cfg.WindowSize.Height > 0 {
+		startArgs = append(startArgs, fmt.Sprintf("--window-size=%d,%d", int(cfg.WindowSize.Width), int(cfg.WindowSize.Height)))
+	}
+	startArgs = append(startArgs, cfg.ExtraArgs...)
+
+	// A configured port of 0 asks Chrome to pick a free one itself; we
+	// learn which one it chose by scanning its startup banner on stderr.
+	autoPort := cfg.DebuggingPort == 0
+	if autoPort {
+		startArgs = append(startArgs, "--remote-debugging-port=0")
+	} else {
+		startArgs = append(startArgs, "--remote-debugging-port="+strconv.Itoa(cfg.DebuggingPort))
+	}
 
 	var chromeBinary string = "google-chrome"
 
@@ -85,21 +195,122 @@ func (b *Browser) startChrome() error {
 
 	// Start Chrome with b.Context.
 	cmd := exec.CommandContext(b.Context, chromeBinary, startArgs...)
-	// Send Chrome stdout and stderr to file descriptor 2 (stderr).
+	// Send Chrome stdout to file descriptor 2 (stderr).
 	cmd.Stdout = os.NewFile(2, "/dev/stderr")
-	cmd.Stderr = os.NewFile(2, "/dev/stderr")
+	if len(cfg.Env) > 0 {
+		cmd.Env = append(os.Environ(), cfg.Env...)
+	}
+
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+
 	// Start Chrome.
-	err := cmd.Start()
+	err = cmd.Start()
 	if err != nil {
 		return err
 	}
+	b.pid = cmd.Process.Pid
+
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+
+	bannerPort := make(chan int, 1)
+	go scanDevToolsBanner(stderr, bannerPort)
+
+	port := cfg.DebuggingPort
+	if autoPort {
+		select {
+		case port = <-bannerPort:
+		case err := <-exited:
+			return fmt.Errorf("chrome exited before printing a DevTools listening banner: %w", err)
+		case <-b.Context.Done():
+			return b.Context.Err()
+		}
+	}
+
+	addr := fmt.Sprintf("http://localhost:%d", port)
+	err = waitForDevTools(b.Context, addr, exited)
+	if err != nil {
+		return err
+	}
+
+	return b.connect(addr)
+}
+
+// devToolsListeningRE matches Chrome's "DevTools listening on
+// ws://127.0.0.1:PORT/devtools/browser/..." startup banner.
+var devToolsListeningRE = regexp.MustCompile(`ws://[^:]+:(\d+)/`)
+
+// scanDevToolsBanner forwards Chrome's stderr, line by line, to the
+// process's own stderr, and reports the port parsed from the DevTools
+// listening banner on bannerPort as soon as it appears.
+func scanDevToolsBanner(stderr io.Reader, bannerPort chan<- int) {
+	sent := false
+	scanner := bufio.NewScanner(stderr)
+	for scanner.Scan() {
+		line := scanner.Text()
+		fmt.Fprintln(os.Stderr, line)
+
+		if sent {
+			continue
+		}
+		if m := devToolsListeningRE.FindStringSubmatch(line); m != nil {
+			if port, err := strconv.Atoi(m[1]); err == nil {
+				bannerPort <- port
+				sent = true
+			}
+		}
+	}
+}
+
+// waitForDevTools polls addr's /json/version endpoint with a bounded
+// backoff until it responds, exited reports Chrome has quit, or ctx's
+// deadline expires, whichever happens first.
+func waitForDevTools(ctx context.Context, addr string, exited <-chan error) error {
+	devt := devtool.New(addr)
+
+	backoff := 20 * time.Millisecond
+	const maxBackoff = 250 * time.Millisecond
+
+	for {
+		_, err := devt.Version(ctx)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case werr := <-exited:
+			return fmt.Errorf("chrome exited before DevTools became ready: %w", werr)
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
 
-	// Wait for Chrome to start.
-	time.Sleep(2 * time.Second)
+// SetDialogHandler registers a handler invoked whenever any tab opens a
+// JavaScript dialog. Without one, dialogs are dismissed so Navigate and
+// Evaluate don't hang waiting on them.
+func (b *Browser) SetDialogHandler(handler func(ev DialogEvent) DialogResponse) {
+	b.dialogHandler = handler
+}
+
+// connect dials the DevTools HTTP address, wraps the already-running Page
+// target as the Browser's current tab, and runs its shared setup (domain
+// enables, dialog watching, viewport measurement). Shared by startChrome
+// (New) and Connect so both constructors end up in the same state.
+func (b *Browser) connect(addr string) error {
+	b.devt = devtool.New(addr)
+	b.tabs = make(map[target.ID]*Tab)
 
-	// Connect to Chrome.
-	devt := devtool.New("http://localhost:9222")
-	pageTarget, err := devt.Get(b.Context, devtool.Page)
+	pageTarget, err := b.devt.Get(b.Context, devtool.Page)
 	if err != nil {
 		return err
 	}
@@ -108,58 +319,221 @@ func (b *Browser) startChrome() error {
 		return err
 	}
 
-	// Create a new cdp.Client.
-	b.Client = cdp.NewClient(conn)
+	t := &Tab{
+		b:        b,
+		targetID: target.ID(pageTarget.ID),
+		conn:     conn,
+		Client:   cdp.NewClient(conn),
+	}
 
-	// Enable the Page domain.
-	err = b.Client.Page.Enable(b.Context)
+	err = t.init(b.Context)
 	if err != nil {
 		return err
 	}
 
-	// Enable the Runtime domain.
-	err = b.Client.Runtime.Enable(b.Context)
+	// Navigate to about:blank to remove the bookmarks bar from window and screen size.
+	err = t.Navigate("about:blank")
 	if err != nil {
 		return err
 	}
 
-	// Navigate to about:blank to remove the bookmarks bar from window and screen size.
-	err = b.Navigate("about:blank")
+	err = t.setupBounds()
 	if err != nil {
 		return err
 	}
 
-	// Get the window size.
-	w, err := b.GetWindowSize()
+	b.current = t
+	b.tabs[t.targetID] = t
+
+	return nil
+}
+
+// NewTab opens url in a new tab via Target.createTarget and returns a Tab
+// that drives it over its own dedicated connection, dialed directly to the
+// target's debugger URL (the same way connect does for the current tab).
+// Unlike the Browser's convenience methods, which always act on the
+// current tab, the returned Tab can be driven directly and concurrently
+// with any other open tab.
+func (b *Browser) NewTab(url string) (*Tab, error) {
+	ctx := b.Context
+
+	cur, err := b.currentTab()
+	if err != nil {
+		return nil, err
+	}
+
+	createReply, err := cur.Client.Target.CreateTarget(ctx, target.NewCreateTargetArgs(url))
+	if err != nil {
+		return nil, fmt.Errorf("create target: %w", err)
+	}
+
+	targets, err := b.devt.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list targets: %w", err)
+	}
+
+	var wsURL string
+	for _, dt := range targets {
+		if target.ID(dt.ID) == createReply.TargetID {
+			wsURL = dt.WebSocketDebuggerURL
+			break
+		}
+	}
+	if wsURL == "" {
+		return nil, fmt.Errorf("target %s not found", createReply.TargetID)
+	}
+
+	conn, err := rpcc.DialContext(ctx, wsURL)
+	if err != nil {
+		return nil, fmt.Errorf("dial target: %w", err)
+	}
+
+	t := &Tab{
+		b:        b,
+		targetID: createReply.TargetID,
+		conn:     conn,
+		Client:   cdp.NewClient(conn),
+	}
+
+	err = t.init(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.setupBounds()
+	if err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	b.tabs[t.targetID] = t
+	b.mu.Unlock()
+
+	return t, nil
+}
+
+// Tabs returns every tab currently open on the Browser, including its
+// current tab.
+func (b *Browser) Tabs() ([]*Tab, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	tabs := make([]*Tab, 0, len(b.tabs))
+	for _, t := range b.tabs {
+		tabs = append(tabs, t)
+	}
+
+	return tabs, nil
+}
+
+// ScreenshotOptions configures Tab.Screenshot and Tab.FullPageScreenshot.
+type ScreenshotOptions struct {
+	Format            string // "png", "jpeg", or "webp"; defaults to "png".
+	Quality           int    // JPEG/WebP quality (0-100); ignored for png.
+	DeviceScaleFactor float64
+	Mobile            bool
+}
+
+// Tab owns a single browser tab/target, with its own dedicated CDP
+// connection, so driving one tab never blocks another.
+type Tab struct {
+	b *Browser
+
+	targetID target.ID
+	conn     *rpcc.Conn
+	Client   *cdp.Client
+
+	Top, Bottom, Left, Right float64
+}
+
+// init enables the domains goplay relies on and starts dialog watching.
+// Shared by every path that creates a Tab. It does not measure the
+// viewport: callers run setupBounds afterward, connect after its
+// about:blank reset and NewTab without navigating the tab.
+func (t *Tab) init(ctx context.Context) error {
+	err := t.Client.Page.Enable(ctx)
 	if err != nil {
 		return err
 	}
 
-	// Get the screen size.
-	s, err := b.GetScreenSize()
+	err = t.Client.Runtime.Enable(ctx)
 	if err != nil {
 		return err
 	}
 
-	// Calculate the Top coordinate of the viewport.
-	b.Top = s.Height - w.Height
+	return t.watchDialogs(ctx)
+}
 
-	// Calculate the Bottom coordinate of the viewport.
-	b.Bottom = s.Height
+// watchDialogs subscribes to Page.javascriptDialogOpening and resolves
+// each dialog via the Browser's registered handler.
+func (t *Tab) watchDialogs(ctx context.Context) error {
+	dialogOpening, err := t.Client.Page.JavascriptDialogOpening(ctx)
+	if err != nil {
+		return err
+	}
 
-	// Calculate the Left coordinate of the viewport.
-	b.Left = 0
+	go func() {
+		defer dialogOpening.Close()
 
-	// Calculate the Right coordinate of the viewport.
-	b.Right = s.Width
+		for {
+			ev, err := dialogOpening.Recv()
+			if err != nil {
+				return
+			}
+
+			handler := t.b.dialogHandler
+			if handler == nil {
+				handler = func(ev DialogEvent) DialogResponse {
+					return DialogResponse{Accept: false}
+				}
+			}
+
+			resp := handler(DialogEvent{Type: string(ev.Type), Message: ev.Message})
+
+			args := page.NewHandleJavaScriptDialogArgs(resp.Accept)
+			if resp.PromptText != "" {
+				args.SetPromptText(resp.PromptText)
+			}
+
+			t.Client.Page.HandleJavaScriptDialog(ctx, args)
+		}
+	}()
+
+	return nil
+}
+
+// Activate brings the tab to the front.
+func (t *Tab) Activate() error {
+	return t.Client.Target.ActivateTarget(t.b.Context, target.NewActivateTargetArgs(t.targetID))
+}
+
+// Close closes the tab's target and its dedicated connection, and removes
+// it from its Browser.
+func (t *Tab) Close() error {
+	_, err := t.Client.Target.CloseTarget(t.b.Context, target.NewCloseTargetArgs(t.targetID))
+	if err != nil {
+		return fmt.Errorf("close target: %w", err)
+	}
+
+	err = t.conn.Close()
+	if err != nil {
+		return fmt.Errorf("close connection: %w", err)
+	}
+
+	t.b.mu.Lock()
+	delete(t.b.tabs, t.targetID)
+	if t.b.current == t {
+		t.b.current = nil
+	}
+	t.b.mu.Unlock()
 
 	return nil
 }
 
 // GetBoundingClientRect returns an DOMRect struct for the given CSS selector.
-func (b *Browser) GetBoundingClientRect(selector string) (*DOMRect, error) {
+func (t *Tab) GetBoundingClientRect(selector string) (*DOMRect, error) {
 	// Get the bounding box of the given selector.
-	s, err := b.Evaluate(fmt.Sprintf(`
+	s, err := t.Evaluate(fmt.Sprintf(`
 	(() => {
 		var rect = document.querySelector("%s").getBoundingClientRect();
 		return JSON.stringify({
@@ -184,9 +558,78 @@ func (b *Browser) GetBoundingClientRect(selector string) (*DOMRect, error) {
 	return &rect, nil
 }
 
+// Screenshot captures a screenshot of the element matching selector.
+func (t *Tab) Screenshot(selector string, opts ScreenshotOptions) ([]byte, error) {
+	rect, err := t.GetBoundingClientRect(selector)
+	if err != nil {
+		return nil, fmt.Errorf("get bounding client rect: %w", err)
+	}
+
+	clip := &page.Viewport{
+		X:      rect.X,
+		Y:      rect.Y,
+		Width:  rect.Width,
+		Height: rect.Height,
+		Scale:  1,
+	}
+
+	return t.captureScreenshot(opts, clip)
+}
+
+// FullPageScreenshot reads the page's full content size via
+// Page.getLayoutMetrics, overrides the emulated device metrics to match
+// (honoring opts.DeviceScaleFactor and opts.Mobile), captures the
+// screenshot, then clears the override.
+func (t *Tab) FullPageScreenshot(opts ScreenshotOptions) ([]byte, error) {
+	metrics, err := t.Client.Page.GetLayoutMetrics(t.b.Context)
+	if err != nil {
+		return nil, fmt.Errorf("get layout metrics: %w", err)
+	}
+
+	content := metrics.CSSContentSize
+
+	overrideArgs := emulation.NewSetDeviceMetricsOverrideArgs(int(content.Width), int(content.Height), opts.DeviceScaleFactor, opts.Mobile)
+	err = t.Client.Emulation.SetDeviceMetricsOverride(t.b.Context, overrideArgs)
+	if err != nil {
+		return nil, fmt.Errorf("set device metrics override: %w", err)
+	}
+	defer t.Client.Emulation.ClearDeviceMetricsOverride(t.b.Context)
+
+	clip := &page.Viewport{
+		X:      content.X,
+		Y:      content.Y,
+		Width:  content.Width,
+		Height: content.Height,
+		Scale:  1,
+	}
+
+	return t.captureScreenshot(opts, clip)
+}
+
+// captureScreenshot invokes Page.captureScreenshot with opts and clip,
+// shared by Screenshot and FullPageScreenshot.
+func (t *Tab) captureScreenshot(opts ScreenshotOptions, clip *page.Viewport) ([]byte, error) {
+	format := opts.Format
+	if format == "" {
+		format = "png"
+	}
+
+	args := page.NewCaptureScreenshotArgs().SetFormat(format).SetClip(*clip)
+	if format != "png" && opts.Quality > 0 {
+		args.SetQuality(opts.Quality)
+	}
+
+	screenshot, err := t.Client.Page.CaptureScreenshot(t.b.Context, args)
+	if err != nil {
+		return nil, fmt.Errorf("capture screenshot: %w", err)
+	}
+
+	return screenshot.Data, nil
+}
+
 // GetWindowSize returns the window size.
-func (b *Browser) GetWindowSize() (*WindowSize, error) {
-	s, err := b.Evaluate(`JSON.stringify({width: window.innerWidth, height: window.innerHeight});`)
+func (t *Tab) GetWindowSize() (*WindowSize, error) {
+	s, err := t.Evaluate(`JSON.stringify({width: window.innerWidth, height: window.innerHeight});`)
 	if err != nil {
 		return nil, err
 	}
@@ -202,8 +645,8 @@ func (b *Browser) GetWindowSize() (*WindowSize, error) {
 }
 
 // GetScreenSize returns the screen size.
-func (b *Browser) GetScreenSize() (*ScreenSize, error) {
-	s, err := b.Evaluate(`JSON.stringify({width: window.screen.width, height: window.screen.height});`)
+func (t *Tab) GetScreenSize() (*ScreenSize, error) {
+	s, err := t.Evaluate(`JSON.stringify({width: window.screen.width, height: window.screen.height});`)
 	if err != nil {
 		return nil, err
 	}
@@ -218,17 +661,38 @@ func (b *Browser) GetScreenSize() (*ScreenSize, error) {
 	return &size, nil
 }
 
+// setupBounds measures the tab's viewport and records it as Top, Bottom,
+// Left, and Right.
+func (t *Tab) setupBounds() error {
+	w, err := t.GetWindowSize()
+	if err != nil {
+		return err
+	}
+
+	s, err := t.GetScreenSize()
+	if err != nil {
+		return err
+	}
+
+	t.Top = s.Height - w.Height
+	t.Bottom = s.Height
+	t.Left = 0
+	t.Right = s.Width
+
+	return nil
+}
+
 // GetIntCoordinates returns the x and y coordinates of the given DOMRect.
-func (b *Browser) GetIntCoordinates(rect *DOMRect) (int, int, error) {
-	x, y := int(rect.X+(rect.Width/2)), int(rect.Y+(rect.Height/2)+b.Top)
+func (t *Tab) GetIntCoordinates(rect *DOMRect) (int, int, error) {
+	x, y := int(rect.X+(rect.Width/2)), int(rect.Y+(rect.Height/2)+t.Top)
 
 	// Check if the y coordinate is between the top and bottom of the screen.
-	if y < int(b.Top) || y > int(b.Bottom) {
+	if y < int(t.Top) || y > int(t.Bottom) {
 		return 0, 0, fmt.Errorf("y coordinate is not between the top and bottom of the screen")
 	}
 
 	// Check if the x coordinate is between the left and right of the screen.
-	if x < int(b.Left) || x > int(b.Right) {
+	if x < int(t.Left) || x > int(t.Right) {
 		return 0, 0, fmt.Errorf("x coordinate is not between the left and right of the screen")
 	}
 
@@ -236,14 +700,16 @@ func (b *Browser) GetIntCoordinates(rect *DOMRect) (int, int, error) {
 }
 
 // Navigate navigates to the given URL.
-func (b *Browser) Navigate(url string) error {
+func (t *Tab) Navigate(url string) error {
+	ctx := t.b.Context
+
 	// Navigate to the page, block until ready.
-	loadEventFired, err := b.Client.Page.LoadEventFired(b.Context)
+	loadEventFired, err := t.Client.Page.LoadEventFired(ctx)
 	if err != nil {
 		return err
 	}
 
-	_, err = b.Client.Page.Navigate(b.Context, page.NewNavigateArgs(url))
+	_, err = t.Client.Page.Navigate(ctx, page.NewNavigateArgs(url))
 	if err != nil {
 		return err
 	}
@@ -258,9 +724,9 @@ func (b *Browser) Navigate(url string) error {
 }
 
 // Evaluate evaluates the given JavaScript expression.
-func (b *Browser) Evaluate(exp string) (string, error) {
+func (t *Tab) Evaluate(exp string) (string, error) {
 	// Evaluate the expression.
-	res, err := b.Client.Runtime.Evaluate(b.Context, runtime.NewEvaluateArgs(exp))
+	res, err := t.Client.Runtime.Evaluate(t.b.Context, runtime.NewEvaluateArgs(exp))
 	if err != nil {
 		return "", err
 	}
@@ -274,3 +740,79 @@ func (b *Browser) Evaluate(exp string) (string, error) {
 
 	return s, nil
 }
+
+// Navigate navigates the Browser's current tab to the given URL.
+func (b *Browser) Navigate(url string) error {
+	t, err := b.currentTab()
+	if err != nil {
+		return err
+	}
+	return t.Navigate(url)
+}
+
+// Evaluate evaluates the given JavaScript expression on the Browser's
+// current tab.
+func (b *Browser) Evaluate(exp string) (string, error) {
+	t, err := b.currentTab()
+	if err != nil {
+		return "", err
+	}
+	return t.Evaluate(exp)
+}
+
+// GetBoundingClientRect returns a DOMRect struct for the given CSS
+// selector on the Browser's current tab.
+func (b *Browser) GetBoundingClientRect(selector string) (*DOMRect, error) {
+	t, err := b.currentTab()
+	if err != nil {
+		return nil, err
+	}
+	return t.GetBoundingClientRect(selector)
+}
+
+// Screenshot captures a screenshot of the element matching selector on
+// the Browser's current tab.
+func (b *Browser) Screenshot(selector string, opts ScreenshotOptions) ([]byte, error) {
+	t, err := b.currentTab()
+	if err != nil {
+		return nil, err
+	}
+	return t.Screenshot(selector, opts)
+}
+
+// FullPageScreenshot captures the Browser's current tab in full.
+func (b *Browser) FullPageScreenshot(opts ScreenshotOptions) ([]byte, error) {
+	t, err := b.currentTab()
+	if err != nil {
+		return nil, err
+	}
+	return t.FullPageScreenshot(opts)
+}
+
+// GetWindowSize returns the Browser's current tab's window size.
+func (b *Browser) GetWindowSize() (*WindowSize, error) {
+	t, err := b.currentTab()
+	if err != nil {
+		return nil, err
+	}
+	return t.GetWindowSize()
+}
+
+// GetScreenSize returns the Browser's current tab's screen size.
+func (b *Browser) GetScreenSize() (*ScreenSize, error) {
+	t, err := b.currentTab()
+	if err != nil {
+		return nil, err
+	}
+	return t.GetScreenSize()
+}
+
+// GetIntCoordinates returns the x and y coordinates of the given DOMRect
+// within the Browser's current tab.
+func (b *Browser) GetIntCoordinates(rect *DOMRect) (int, int, error) {
+	t, err := b.currentTab()
+	if err != nil {
+		return 0, 0, err
+	}
+	return t.GetIntCoordinates(rect)
+}
@@ -0,0 +1,39 @@
+package chrome
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScanDevToolsBannerParsesPort(t *testing.T) {
+	stderr := strings.NewReader(
+		"[0726/120000.000000:INFO:CONSOLE] ready\n" +
+			"DevTools listening on ws://127.0.0.1:54321/devtools/browser/abc-123\n" +
+			"[0726/120000.000001:INFO:CONSOLE] extra noise\n",
+	)
+
+	bannerPort := make(chan int, 1)
+	scanDevToolsBanner(stderr, bannerPort)
+
+	select {
+	case port := <-bannerPort:
+		if port != 54321 {
+			t.Errorf("got port %d, want 54321", port)
+		}
+	default:
+		t.Fatal("scanDevToolsBanner never sent a port")
+	}
+}
+
+func TestScanDevToolsBannerNoBanner(t *testing.T) {
+	stderr := strings.NewReader("nothing interesting here\n")
+
+	bannerPort := make(chan int, 1)
+	scanDevToolsBanner(stderr, bannerPort)
+
+	select {
+	case port := <-bannerPort:
+		t.Fatalf("got unexpected port %d, want no send", port)
+	default:
+	}
+}
@@ -0,0 +1,41 @@
+package chrome
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCubicBezierEndpoints(t *testing.T) {
+	x0, y0 := 0.0, 0.0
+	x3, y3 := 100.0, 50.0
+
+	x, y := cubicBezier(x0, y0, 10, 40, 60, 80, x3, y3, 0)
+	if x != x0 || y != y0 {
+		t.Errorf("at t=0, got (%v, %v), want (%v, %v)", x, y, x0, y0)
+	}
+
+	x, y = cubicBezier(x0, y0, 10, 40, 60, 80, x3, y3, 1)
+	if x != x3 || y != y3 {
+		t.Errorf("at t=1, got (%v, %v), want (%v, %v)", x, y, x3, y3)
+	}
+}
+
+func TestCubicBezierMidpoint(t *testing.T) {
+	// With control points equal to the endpoints, the curve degenerates
+	// to a straight line, so t=0.5 must land at the line's midpoint.
+	x, y := cubicBezier(0, 0, 0, 0, 10, 10, 10, 10, 0.5)
+	if math.Abs(x-5) > 1e-9 || math.Abs(y-5) > 1e-9 {
+		t.Errorf("at t=0.5, got (%v, %v), want (5, 5)", x, y)
+	}
+}
+
+func TestJitterWithinBounds(t *testing.T) {
+	min, max := 4*time.Millisecond, 16*time.Millisecond
+	for i := 0; i < 100; i++ {
+		d := jitter(min, max)
+		if d < min || d >= max {
+			t.Fatalf("jitter returned %v, want in [%v, %v)", d, min, max)
+		}
+	}
+}